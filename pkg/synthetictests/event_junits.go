@@ -25,6 +25,7 @@ func StableSystemEventInvariants(events monitorapi.Intervals, duration time.Dura
 	tests = append(tests, testAllAPIAvailability(events, duration)...)
 	tests = append(tests, testAllIngressAvailability(events, duration)...)
 	tests = append(tests, testStableSystemOperatorStateTransitions(events)...)
+	tests = append(tests, testOperatorVersionRegressions(events)...)
 	tests = append(tests, testDuplicatedEventForStableSystem(events, kubeClientConfig, testSuite)...)
 	tests = append(tests, testErrImagePullConnTimeoutOpenShiftNamespaces(events)...)
 	tests = append(tests, testErrImagePullConnTimeout(events)...)
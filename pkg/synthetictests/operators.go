@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/blang/semver"
+
 	"github.com/openshift/origin/pkg/synthetictests/platformidentification"
 	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
 
@@ -169,6 +171,61 @@ func testOperatorOSUpdateStaged(events monitorapi.Intervals, clientConfig *rest.
 	return []*junitapi.JUnitTestCase{success}
 }
 
+// testOperatorVersionRegressions fails if any ClusterOperator's "operator"
+// version moved backwards while the monitor was watching, which indicates a
+// rollback or a confused operator. It only belongs in stable-system
+// invariants: upgrade runs may roll back on purpose (--abort-at).
+func testOperatorVersionRegressions(events monitorapi.Intervals) []*junitapi.JUnitTestCase {
+	testName := "[sig-arch] ClusterOperators should not regress their reported operator version"
+
+	var regressions []string
+	for _, event := range events {
+		operatorName, ok := monitorapi.OperatorFromLocator(event.Locator)
+		if !ok || !strings.HasPrefix(event.Message, "versions: ") {
+			continue
+		}
+		// the monitor records changes as "versions: <name> <old> -> <new>, ..."
+		for _, change := range strings.Split(strings.TrimPrefix(event.Message, "versions: "), ", ") {
+			fields := strings.Fields(change)
+			if len(fields) != 4 || fields[0] != "operator" || fields[2] != "->" {
+				continue
+			}
+			previous, current := fields[1], fields[3]
+			if versionRegressed(previous, current) {
+				regressions = append(regressions, fmt.Sprintf("%v: clusteroperator/%s went from version %q to %q", event.From.Format(time.RFC3339), operatorName, previous, current))
+			}
+		}
+	}
+
+	if len(regressions) > 0 {
+		output := fmt.Sprintf("%d clusteroperator version regressions:\n\n%s", len(regressions), strings.Join(regressions, "\n"))
+		return []*junitapi.JUnitTestCase{{
+			Name:      testName,
+			SystemOut: output,
+			FailureOutput: &junitapi.FailureOutput{
+				Output: output,
+			},
+		}}
+	}
+	return []*junitapi.JUnitTestCase{{Name: testName}}
+}
+
+// versionRegressed reports whether current is older than previous. Versions
+// are compared by major, minor and patch when both parse as semver; the
+// prerelease is ignored because CI payload names (e.g. "0.ci-2022-01-10-..."
+// vs "0.ci.test-2022-01-11-...") do not follow semver precedence. Otherwise
+// any change is treated as a regression since no ordering can be established.
+func versionRegressed(previous, current string) bool {
+	previousVersion, previousErr := semver.Parse(strings.TrimPrefix(previous, "v"))
+	currentVersion, currentErr := semver.Parse(strings.TrimPrefix(current, "v"))
+	if previousErr != nil || currentErr != nil {
+		return previous != current
+	}
+	previousVersion.Pre, previousVersion.Build = nil, nil
+	currentVersion.Pre, currentVersion.Build = nil, nil
+	return currentVersion.LT(previousVersion)
+}
+
 func allOperators(events monitorapi.Intervals) sets.String {
 	// start with a list of known values
 	knownOperators := sets.NewString(KnownOperators.List()...)
@@ -0,0 +1,175 @@
+package synthetictests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestVersionRegressed(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		current  string
+		expected bool
+	}{
+		{
+			name:     "semver upgrade",
+			previous: "4.9.0",
+			current:  "4.10.0",
+			expected: false,
+		},
+		{
+			name:     "semver unchanged",
+			previous: "4.10.0",
+			current:  "4.10.0",
+			expected: false,
+		},
+		{
+			name:     "semver rollback",
+			previous: "4.10.0",
+			current:  "4.9.0",
+			expected: true,
+		},
+		{
+			name:     "v prefix upgrade",
+			previous: "v4.9.0",
+			current:  "4.10.0",
+			expected: false,
+		},
+		{
+			name:     "v prefix rollback",
+			previous: "v4.10.0",
+			current:  "v4.9.0",
+			expected: true,
+		},
+		{
+			name:     "prerelease to release",
+			previous: "4.10.0-0.nightly-2022-01-11-065245",
+			current:  "4.10.0",
+			expected: false,
+		},
+		{
+			name:     "release to prerelease of the same version",
+			previous: "4.10.0",
+			current:  "4.10.0-0.nightly-2022-01-11-065245",
+			expected: false,
+		},
+		{
+			name:     "ci to ci.test payload",
+			previous: "4.10.0-0.ci-2022-01-10-151420",
+			current:  "4.10.0-0.ci.test-2022-01-11-065245-ci-op-3l7sgy2c",
+			expected: false,
+		},
+		{
+			name:     "nightly to ci payload",
+			previous: "4.10.0-0.nightly-2022-01-11-065245",
+			current:  "4.10.0-0.ci-2022-01-10-151420",
+			expected: false,
+		},
+		{
+			name:     "prerelease of an older version",
+			previous: "4.10.0-0.nightly-2022-01-11-065245",
+			current:  "4.9.0-0.nightly-2022-01-11-065245",
+			expected: true,
+		},
+		{
+			name:     "non-semver previous changed",
+			previous: "latest",
+			current:  "4.10.0",
+			expected: true,
+		},
+		{
+			name:     "non-semver current changed",
+			previous: "4.10.0",
+			current:  "latest",
+			expected: true,
+		},
+		{
+			name:     "non-semver unchanged",
+			previous: "latest",
+			current:  "latest",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := versionRegressed(test.previous, test.current); actual != test.expected {
+				t.Errorf("versionRegressed(%q, %q) was %v, expected %v", test.previous, test.current, actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestOperatorVersionRegressions(t *testing.T) {
+	versionChange := func(operator, message string) monitorapi.EventInterval {
+		now := time.Now()
+		return monitorapi.EventInterval{
+			Condition: monitorapi.Condition{
+				Level:   monitorapi.Info,
+				Locator: monitorapi.OperatorLocator(operator),
+				Message: message,
+			},
+			From: now,
+			To:   now,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		events       monitorapi.Intervals
+		expectFailed bool
+	}{
+		{
+			name:         "no events",
+			expectFailed: false,
+		},
+		{
+			name: "upgrade",
+			events: monitorapi.Intervals{
+				versionChange("etcd", "versions: raw-internal 4.9.0 -> 4.10.0, operator 4.9.0 -> 4.10.0"),
+			},
+			expectFailed: false,
+		},
+		{
+			name: "operator rollback",
+			events: monitorapi.Intervals{
+				versionChange("etcd", "versions: raw-internal 4.9.0 -> 4.10.0, operator 4.10.0 -> 4.9.0"),
+			},
+			expectFailed: true,
+		},
+		{
+			name: "other operand rollback",
+			events: monitorapi.Intervals{
+				versionChange("etcd", "versions: raw-internal 4.10.0 -> 4.9.0"),
+			},
+			expectFailed: false,
+		},
+		{
+			name: "not an operator",
+			events: monitorapi.Intervals{
+				{
+					Condition: monitorapi.Condition{
+						Locator: "clusterversion/version",
+						Message: "versions: operator 4.10.0 -> 4.9.0",
+					},
+				},
+			},
+			expectFailed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			junits := testOperatorVersionRegressions(test.events)
+			if len(junits) != 1 {
+				t.Fatalf("expected a single test case, got %d", len(junits))
+			}
+			if failed := junits[0].FailureOutput != nil; failed != test.expectFailed {
+				t.Errorf("expected failed %v, got %v: %#v", test.expectFailed, failed, junits[0].FailureOutput)
+			}
+		})
+	}
+}
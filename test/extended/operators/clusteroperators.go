@@ -2,13 +2,22 @@ package operators
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
 
 	g "github.com/onsi/ginkgo"
 	o "github.com/onsi/gomega"
 	s "github.com/onsi/gomega/gstruct"
 	t "github.com/onsi/gomega/types"
 
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/kube-openapi/pkg/util/sets"
 	e2e "k8s.io/kubernetes/test/e2e/framework"
 
@@ -18,9 +27,14 @@ import (
 	exutil "github.com/openshift/origin/test/extended/util"
 )
 
+// progressingThreshold is how long a ClusterOperator may report
+// Progressing=True before it is considered stuck.
+var progressingThreshold = 30 * time.Minute
+
 var _ = g.Describe("[sig-arch] ClusterOperators", func() {
 	defer g.GinkgoRecover()
 
+	oc := exutil.NewCLI("clusteroperators")
 	var clusterOperators []config.ClusterOperator
 	whitelistNoNamespace := sets.NewString(
 		"cloud-credential",
@@ -43,8 +57,29 @@ var _ = g.Describe("[sig-arch] ClusterOperators", func() {
 		"operator-lifecycle-manager-catalog",
 		"support",
 	)
+	// operators that install CustomResourceDefinitions and are expected to
+	// surface them among their related objects
+	operatorsOwningCRDs := sets.NewString(
+		"csi-snapshot-controller",
+	)
+	// operators with a justified need to list secrets among their related
+	// objects; everyone else should reference the owning namespace instead
+	whitelistRelatedSecrets := sets.NewString()
+	whitelistMissingConditions := sets.NewString()
+	whitelistNoOperatorVersion := sets.NewString()
+	whitelistLongProgressing := sets.NewString()
 
 	g.BeforeEach(func() {
+		// Downstream distributions may extend, but never shrink, the built-in
+		// lists without patching this test; see test/extended/README.md.
+		insertFromEnv(whitelistNoNamespace, "CLUSTEROPERATOR_NO_NAMESPACE_WHITELIST")
+		insertFromEnv(whitelistNoOperatorConfig, "CLUSTEROPERATOR_NO_OPERATOR_CONFIG_WHITELIST")
+		insertFromEnv(operatorsOwningCRDs, "CLUSTEROPERATOR_OWNING_CRDS")
+		insertFromEnv(whitelistRelatedSecrets, "CLUSTEROPERATOR_RELATED_SECRETS_WHITELIST")
+		insertFromEnv(whitelistMissingConditions, "CLUSTEROPERATOR_MISSING_CONDITIONS_WHITELIST")
+		insertFromEnv(whitelistNoOperatorVersion, "CLUSTEROPERATOR_NO_OPERATOR_VERSION_WHITELIST")
+		insertFromEnv(whitelistLongProgressing, "CLUSTEROPERATOR_LONG_PROGRESSING_WHITELIST")
+
 		kubeConfig, err := e2e.LoadConfig()
 		o.Expect(err).ToNot(o.HaveOccurred())
 		configClient, err := configclient.NewForConfig(kubeConfig)
@@ -64,7 +99,6 @@ var _ = g.Describe("[sig-arch] ClusterOperators", func() {
 
 		})
 
-		oc := exutil.NewCLI("clusteroperators")
 		g.Specify("at least one related object that is not a namespace", func() {
 			controlplaneTopology, err := exutil.GetControlPlaneTopology(oc)
 			o.Expect(err).NotTo(o.HaveOccurred())
@@ -81,12 +115,208 @@ var _ = g.Describe("[sig-arch] ClusterOperators", func() {
 			}
 		})
 
+		g.Specify("related objects that exist in the cluster", func() {
+			mapper := oc.RESTMapper()
+			dynamicClient := oc.AdminDynamicClient()
+
+			var missing []string
+			for _, clusterOperator := range clusterOperators {
+				for _, relatedObject := range clusterOperator.Status.RelatedObjects {
+					// references without a name select a whole collection
+					// and have no single object to look up
+					if len(relatedObject.Name) == 0 {
+						continue
+					}
+					gvr, err := mapper.ResourceFor(schema.GroupVersionResource{Group: relatedObject.Group, Resource: relatedObject.Resource})
+					if meta.IsNoMatchError(err) {
+						missing = append(missing, fmt.Sprintf("ClusterOperator %s: %#v: %v", clusterOperator.Name, relatedObject, err))
+						continue
+					}
+					o.Expect(err).NotTo(o.HaveOccurred())
+
+					_, err = dynamicClient.Resource(gvr).Namespace(relatedObject.Namespace).Get(context.Background(), relatedObject.Name, metav1.GetOptions{})
+					switch {
+					case err == nil:
+					case errors.IsNotFound(err):
+						missing = append(missing, fmt.Sprintf("ClusterOperator %s: %#v", clusterOperator.Name, relatedObject))
+					case errors.IsForbidden(err):
+						e2e.Logf("warning: ClusterOperator %s: not allowed to get %#v: %v", clusterOperator.Name, relatedObject, err)
+					default:
+						o.Expect(err).NotTo(o.HaveOccurred())
+					}
+				}
+			}
+			o.Expect(missing).To(o.BeEmpty())
+		})
+
+		g.Specify("at least one custom resource definition in the related objects of operators that own CRDs", func() {
+			for _, clusterOperator := range clusterOperators {
+				if operatorsOwningCRDs.Has(clusterOperator.Name) {
+					o.Expect(clusterOperator.Status.RelatedObjects).To(o.ContainElement(isCustomResourceDefinition()), "ClusterOperator: %s", clusterOperator.Name)
+				}
+			}
+		})
+
+		g.Specify("the Available, Progressing and Degraded conditions", func() {
+			var missing []string
+			for _, clusterOperator := range clusterOperators {
+				if whitelistMissingConditions.Has(clusterOperator.Name) {
+					continue
+				}
+				for _, conditionType := range []config.ClusterStatusConditionType{config.OperatorAvailable, config.OperatorProgressing, config.OperatorDegraded} {
+					condition := findCondition(clusterOperator.Status.Conditions, conditionType)
+					switch {
+					case condition == nil:
+						missing = append(missing, fmt.Sprintf("ClusterOperator %s has no %s condition", clusterOperator.Name, conditionType))
+					case len(condition.Reason) == 0:
+						missing = append(missing, fmt.Sprintf("ClusterOperator %s has no reason on its %s condition", clusterOperator.Name, conditionType))
+					case condition.LastTransitionTime.IsZero():
+						missing = append(missing, fmt.Sprintf("ClusterOperator %s has no lastTransitionTime on its %s condition", clusterOperator.Name, conditionType))
+					}
+				}
+			}
+			o.Expect(missing).To(o.BeEmpty())
+		})
+
+		g.Specify("a non-empty, well-formed operator version", func() {
+			var malformed []string
+			for _, clusterOperator := range clusterOperators {
+				if whitelistNoOperatorVersion.Has(clusterOperator.Name) {
+					continue
+				}
+				version := operatorVersion(clusterOperator)
+				if len(version) == 0 {
+					malformed = append(malformed, fmt.Sprintf("ClusterOperator %s reports no operator version", clusterOperator.Name))
+					continue
+				}
+				if _, err := semver.Parse(strings.TrimPrefix(version, "v")); err != nil {
+					malformed = append(malformed, fmt.Sprintf("ClusterOperator %s reports malformed operator version %q: %v", clusterOperator.Name, version, err))
+				}
+			}
+			o.Expect(malformed).To(o.BeEmpty())
+		})
+
+	})
+
+	g.Specify("should not list secrets among their related objects", func() {
+		for _, clusterOperator := range clusterOperators {
+			if !whitelistRelatedSecrets.Has(clusterOperator.Name) {
+				o.Expect(clusterOperator.Status.RelatedObjects).NotTo(o.ContainElement(isSecret()), "ClusterOperator: %s", clusterOperator.Name)
+			}
+		}
+	})
+
+	g.Specify("should not be stuck progressing", func() {
+		var stuck []string
+		for _, clusterOperator := range clusterOperators {
+			if whitelistLongProgressing.Has(clusterOperator.Name) {
+				continue
+			}
+			condition := findCondition(clusterOperator.Status.Conditions, config.OperatorProgressing)
+			// a missing lastTransitionTime is reported by the conditions
+			// check; without it there is no way to tell how long this has
+			// been progressing
+			if condition == nil || condition.Status != config.ConditionTrue || condition.LastTransitionTime.IsZero() {
+				continue
+			}
+			if since := time.Since(condition.LastTransitionTime.Time); since > progressingThreshold {
+				stuck = append(stuck, fmt.Sprintf("ClusterOperator %s has been progressing for %s: %s", clusterOperator.Name, since.Round(time.Second), condition.Message))
+			}
+		}
+		o.Expect(stuck).To(o.BeEmpty())
+	})
+
+	g.Specify("should not list related objects without a name", func() {
+		mapper := oc.RESTMapper()
+
+		var malformed []string
+		for _, clusterOperator := range clusterOperators {
+			for _, relatedObject := range clusterOperator.Status.RelatedObjects {
+				missingName, err := isMissingName(mapper, relatedObject)
+				switch {
+				case err != nil:
+					malformed = append(malformed, fmt.Sprintf("ClusterOperator %s: %#v: %v", clusterOperator.Name, relatedObject, err))
+				case missingName:
+					malformed = append(malformed, fmt.Sprintf("ClusterOperator %s: %#v", clusterOperator.Name, relatedObject))
+				}
+			}
+		}
+		o.Expect(malformed).To(o.BeEmpty())
 	})
 })
 
-func isNamespace() t.GomegaMatcher {
+// insertFromEnv adds the comma-separated entries of the named environment
+// variable to whitelist. An unset or empty variable leaves it unchanged.
+func insertFromEnv(whitelist sets.String, name string) {
+	for _, entry := range strings.Split(os.Getenv(name), ",") {
+		if entry = strings.TrimSpace(entry); len(entry) > 0 {
+			whitelist.Insert(entry)
+		}
+	}
+}
+
+// isResource matches a related object of the given group and resource.
+func isResource(group, resource string) t.GomegaMatcher {
 	return s.MatchFields(s.IgnoreExtras|s.IgnoreMissing, s.Fields{
-		"Resource": o.Equal("namespaces"),
-		"Group":    o.Equal(""),
+		"Resource": o.Equal(resource),
+		"Group":    o.Equal(group),
 	})
 }
+
+func isNamespace() t.GomegaMatcher {
+	return isResource("", "namespaces")
+}
+
+func isCustomResourceDefinition() t.GomegaMatcher {
+	return isResource("apiextensions.k8s.io", "customresourcedefinitions")
+}
+
+func isSecret() t.GomegaMatcher {
+	return isResource("", "secrets")
+}
+
+func findCondition(conditions []config.ClusterOperatorStatusCondition, conditionType config.ClusterStatusConditionType) *config.ClusterOperatorStatusCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// isMissingName reports whether a related object omits a name it needs. An
+// unnamed reference is the collection form, which must-gather expands to
+// every resource of that type: cluster-wide for cluster-scoped resources, or
+// within the given namespace for namespaced ones. Only a namespace itself, or
+// a namespaced resource without a namespace, must be named.
+func isMissingName(mapper meta.RESTMapper, relatedObject config.ObjectReference) (bool, error) {
+	if len(relatedObject.Name) > 0 {
+		return false, nil
+	}
+	if len(relatedObject.Group) == 0 && relatedObject.Resource == "namespaces" {
+		return true, nil
+	}
+	gvk, err := mapper.KindFor(schema.GroupVersionResource{Group: relatedObject.Group, Resource: relatedObject.Resource})
+	if err != nil {
+		return false, err
+	}
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return false, nil
+	}
+	return len(relatedObject.Namespace) == 0, nil
+}
+
+// operatorVersion returns the version the ClusterOperator reports for the
+// "operator" operand, or the empty string if it reports none.
+func operatorVersion(clusterOperator config.ClusterOperator) string {
+	for _, version := range clusterOperator.Status.Versions {
+		if version.Name == "operator" {
+			return version.Version
+		}
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+package operators
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	config "github.com/openshift/api/config/v1"
+)
+
+func TestIsResource(t *testing.T) {
+	tests := []struct {
+		name          string
+		relatedObject config.ObjectReference
+		group         string
+		resource      string
+		expectMatch   bool
+	}{
+		{
+			name:          "deployment matches deployments",
+			relatedObject: config.ObjectReference{Group: "apps", Resource: "deployments", Namespace: "openshift-foo", Name: "foo"},
+			group:         "apps",
+			resource:      "deployments",
+			expectMatch:   true,
+		},
+		{
+			name:          "namespace does not match deployments",
+			relatedObject: config.ObjectReference{Resource: "namespaces", Name: "openshift-foo"},
+			group:         "apps",
+			resource:      "deployments",
+			expectMatch:   false,
+		},
+		{
+			name:          "deployment does not match namespaces",
+			relatedObject: config.ObjectReference{Group: "apps", Resource: "deployments", Namespace: "openshift-foo", Name: "foo"},
+			group:         "",
+			resource:      "namespaces",
+			expectMatch:   false,
+		},
+		{
+			name:          "group must match",
+			relatedObject: config.ObjectReference{Group: "extensions", Resource: "deployments", Namespace: "openshift-foo", Name: "foo"},
+			group:         "apps",
+			resource:      "deployments",
+			expectMatch:   false,
+		},
+	}
+
+	for _, test := range tests {
+		match, err := isResource(test.group, test.resource).Match(test.relatedObject)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.name, err)
+			continue
+		}
+		if match != test.expectMatch {
+			t.Errorf("%q: expectMatch was %v but match was %v", test.name, test.expectMatch, match)
+		}
+	}
+
+	match, err := isNamespace().Match(config.ObjectReference{Resource: "namespaces", Name: "openshift-foo"})
+	if err != nil || !match {
+		t.Errorf("isNamespace did not match a namespace: match=%v err=%v", match, err)
+	}
+}
+
+func TestIsMissingName(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+
+	tests := []struct {
+		name          string
+		relatedObject config.ObjectReference
+		expectMissing bool
+		expectError   bool
+	}{
+		{
+			name:          "named namespaced object",
+			relatedObject: config.ObjectReference{Resource: "configmaps", Namespace: "openshift-foo", Name: "foo"},
+		},
+		{
+			name:          "namespace-wide collection",
+			relatedObject: config.ObjectReference{Resource: "configmaps", Namespace: "openshift-foo"},
+		},
+		{
+			name:          "namespaced resource without namespace or name",
+			relatedObject: config.ObjectReference{Resource: "configmaps"},
+			expectMissing: true,
+		},
+		{
+			name:          "named namespace",
+			relatedObject: config.ObjectReference{Resource: "namespaces", Name: "openshift-foo"},
+		},
+		{
+			name:          "unnamed namespace",
+			relatedObject: config.ObjectReference{Resource: "namespaces", Namespace: "openshift-foo"},
+			expectMissing: true,
+		},
+		{
+			name:          "named cluster-scoped object",
+			relatedObject: config.ObjectReference{Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Name: "foo"},
+		},
+		{
+			name:          "cluster-wide collection",
+			relatedObject: config.ObjectReference{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+		},
+		{
+			name:          "unnamed cluster-scoped object with a namespace",
+			relatedObject: config.ObjectReference{Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Namespace: "openshift-foo"},
+		},
+		{
+			name:          "unnamed namespace without a namespace",
+			relatedObject: config.ObjectReference{Resource: "namespaces"},
+			expectMissing: true,
+		},
+		{
+			name:          "unknown resource",
+			relatedObject: config.ObjectReference{Group: "example.com", Resource: "widgets", Namespace: "openshift-foo"},
+			expectError:   true,
+		},
+		{
+			name:          "named unknown resource is not looked up",
+			relatedObject: config.ObjectReference{Group: "example.com", Resource: "widgets", Namespace: "openshift-foo", Name: "foo"},
+		},
+	}
+
+	for _, test := range tests {
+		missing, err := isMissingName(mapper, test.relatedObject)
+		if (err != nil) != test.expectError {
+			t.Errorf("%q: expectError was %v but err was %v", test.name, test.expectError, err)
+			continue
+		}
+		if missing != test.expectMissing {
+			t.Errorf("%q: expectMissing was %v but missing was %v", test.name, test.expectMissing, missing)
+		}
+	}
+}
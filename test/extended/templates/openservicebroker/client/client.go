@@ -36,11 +36,18 @@ func NewClient(cli *http.Client, root string) Client {
 }
 
 type ServerError struct {
-	StatusCode  int
+	StatusCode int
+	// ErrorCode is the machine-readable error code from the broker's error
+	// body (e.g. "AsyncRequired", "ConcurrencyError"); it is empty when the
+	// broker did not send one.
+	ErrorCode   string
 	Description string
 }
 
 func (e *ServerError) Error() string {
+	if len(e.ErrorCode) > 0 {
+		return fmt.Sprintf("%s: %s: %s", http.StatusText(e.StatusCode), e.ErrorCode, e.Description)
+	}
 	return fmt.Sprintf("%s: %s", http.StatusText(e.StatusCode), e.Description)
 }
 
@@ -48,6 +55,10 @@ func newServerError(statusCode int, description string) error {
 	return &ServerError{StatusCode: statusCode, Description: description}
 }
 
+func newServerErrorFromResponse(statusCode int, r *openservicebrokerapi.ErrorResponse) error {
+	return &ServerError{StatusCode: statusCode, ErrorCode: r.Error, Description: r.Description}
+}
+
 func (c *client) Client() *http.Client {
 	return c.cli
 }
@@ -64,7 +75,7 @@ func OriginatingIdentityHeader(u user.Info) (string, error) {
 }
 
 func (c *client) Catalog(ctx context.Context) (*openservicebrokerapi.CatalogResponse, error) {
-	req, err := http.NewRequest(http.MethodGet, c.root+"/v2/catalog", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.root+"/v2/catalog", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +107,7 @@ func (c *client) Catalog(ctx context.Context) (*openservicebrokerapi.CatalogResp
 	if err != nil {
 		return nil, err
 	}
-	return nil, newServerError(resp.StatusCode, r.Description)
+	return nil, newServerErrorFromResponse(resp.StatusCode, r)
 }
 
 func (c *client) Provision(ctx context.Context, u user.Info, instanceID string, preq *openservicebrokerapi.ProvisionRequest) (*openservicebrokerapi.ProvisionResponse, error) {
@@ -110,7 +121,7 @@ func (c *client) Provision(ctx context.Context, u user.Info, instanceID string,
 		pw.CloseWithError(e.Encode(preq))
 	}()
 
-	req, err := http.NewRequest(http.MethodPut, c.root+"/v2/service_instances/"+instanceID+"?accepts_incomplete=true", pr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.root+"/v2/service_instances/"+instanceID+"?accepts_incomplete=true", pr)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +174,7 @@ func (c *client) Provision(ctx context.Context, u user.Info, instanceID string,
 	if err != nil {
 		return nil, err
 	}
-	return nil, newServerError(resp.StatusCode, r.Description)
+	return nil, newServerErrorFromResponse(resp.StatusCode, r)
 }
 
 func (c *client) Deprovision(ctx context.Context, u user.Info, instanceID string) error {
@@ -171,7 +182,7 @@ func (c *client) Deprovision(ctx context.Context, u user.Info, instanceID string
 		return errs.ToAggregate()
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, c.root+"/v2/service_instances/"+instanceID+"?accepts_incomplete=true", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.root+"/v2/service_instances/"+instanceID+"?accepts_incomplete=true", nil)
 	if err != nil {
 		return err
 	}
@@ -223,7 +234,7 @@ func (c *client) Deprovision(ctx context.Context, u user.Info, instanceID string
 	if err != nil {
 		return err
 	}
-	return newServerError(resp.StatusCode, r.Description)
+	return newServerErrorFromResponse(resp.StatusCode, r)
 }
 
 func (c *client) LastOperation(ctx context.Context, u user.Info, instanceID string, operation openservicebrokerapi.Operation) (*openservicebrokerapi.LastOperationResponse, error) {
@@ -231,7 +242,7 @@ func (c *client) LastOperation(ctx context.Context, u user.Info, instanceID stri
 		return nil, errs.ToAggregate()
 	}
 
-	req, err := http.NewRequest(http.MethodGet, c.root+"/v2/service_instances/"+instanceID+"/last_operation?operation="+string(operation), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.root+"/v2/service_instances/"+instanceID+"/last_operation?operation="+string(operation), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +280,7 @@ func (c *client) LastOperation(ctx context.Context, u user.Info, instanceID stri
 	if err != nil {
 		return nil, err
 	}
-	return nil, newServerError(resp.StatusCode, r.Description)
+	return nil, newServerErrorFromResponse(resp.StatusCode, r)
 }
 
 func (c *client) WaitForOperation(ctx context.Context, u user.Info, instanceID string, operation openservicebrokerapi.Operation) (openservicebrokerapi.LastOperationState, error) {
@@ -287,10 +298,8 @@ func (c *client) WaitForOperation(ctx context.Context, u user.Info, instanceID s
 		select {
 		case <-done:
 			return openservicebrokerapi.LastOperationStateFailed, ctx.Err()
-		default:
+		case <-time.After(1 * time.Second):
 		}
-
-		time.Sleep(1 * time.Second)
 	}
 }
 
@@ -309,7 +318,7 @@ func (c *client) Bind(ctx context.Context, u user.Info, instanceID, bindingID st
 		pw.CloseWithError(e.Encode(breq))
 	}()
 
-	req, err := http.NewRequest(http.MethodPut, c.root+"/v2/service_instances/"+instanceID+"/service_bindings/"+bindingID, pr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.root+"/v2/service_instances/"+instanceID+"/service_bindings/"+bindingID, pr)
 	if err != nil {
 		return nil, err
 	}
@@ -348,7 +357,7 @@ func (c *client) Bind(ctx context.Context, u user.Info, instanceID, bindingID st
 	if err != nil {
 		return nil, err
 	}
-	return nil, newServerError(resp.StatusCode, r.Description)
+	return nil, newServerErrorFromResponse(resp.StatusCode, r)
 }
 
 func (c *client) Unbind(ctx context.Context, u user.Info, instanceID, bindingID string) error {
@@ -360,7 +369,7 @@ func (c *client) Unbind(ctx context.Context, u user.Info, instanceID, bindingID
 		return errs.ToAggregate()
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, c.root+"/v2/service_instances/"+instanceID+"/service_bindings/"+bindingID, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.root+"/v2/service_instances/"+instanceID+"/service_bindings/"+bindingID, nil)
 	if err != nil {
 		return err
 	}
@@ -398,5 +407,5 @@ func (c *client) Unbind(ctx context.Context, u user.Info, instanceID, bindingID
 	if err != nil {
 		return err
 	}
-	return newServerError(resp.StatusCode, r.Description)
+	return newServerErrorFromResponse(resp.StatusCode, r)
 }
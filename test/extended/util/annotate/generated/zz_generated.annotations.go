@@ -601,10 +601,24 @@ var annotations = map[string]string{
 
 	"[Top Level] [sig-arch] Cluster topology single node tests Verify that OpenShift components deploy one replica in SingleReplica topology mode": "Verify that OpenShift components deploy one replica in SingleReplica topology mode [Suite:openshift/conformance/parallel]",
 
+	"[Top Level] [sig-arch] ClusterOperators should define a non-empty, well-formed operator version": "a non-empty, well-formed operator version [Suite:openshift/conformance/parallel]",
+
+	"[Top Level] [sig-arch] ClusterOperators should define at least one custom resource definition in the related objects of operators that own CRDs": "at least one custom resource definition in the related objects of operators that own CRDs [Suite:openshift/conformance/parallel]",
+
 	"[Top Level] [sig-arch] ClusterOperators should define at least one namespace in their lists of related objects": "at least one namespace in their lists of related objects [Suite:openshift/conformance/parallel]",
 
 	"[Top Level] [sig-arch] ClusterOperators should define at least one related object that is not a namespace": "at least one related object that is not a namespace [Suite:openshift/conformance/parallel]",
 
+	"[Top Level] [sig-arch] ClusterOperators should define related objects that exist in the cluster": "related objects that exist in the cluster [Suite:openshift/conformance/parallel]",
+
+	"[Top Level] [sig-arch] ClusterOperators should define the Available, Progressing and Degraded conditions": "the Available, Progressing and Degraded conditions [Suite:openshift/conformance/parallel]",
+
+	"[Top Level] [sig-arch] ClusterOperators should not be stuck progressing": "should not be stuck progressing [Suite:openshift/conformance/parallel]",
+
+	"[Top Level] [sig-arch] ClusterOperators should not list related objects without a name": "should not list related objects without a name [Suite:openshift/conformance/parallel]",
+
+	"[Top Level] [sig-arch] ClusterOperators should not list secrets among their related objects": "should not list secrets among their related objects [Suite:openshift/conformance/parallel]",
+
 	"[Top Level] [sig-arch] Managed cluster should ensure control plane operators do not make themselves unevictable": "ensure control plane operators do not make themselves unevictable [Suite:openshift/conformance/parallel]",
 
 	"[Top Level] [sig-arch] Managed cluster should ensure control plane pods do not run in best-effort QoS": "ensure control plane pods do not run in best-effort QoS [Suite:openshift/conformance/parallel]",